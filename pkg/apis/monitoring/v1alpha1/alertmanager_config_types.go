@@ -16,7 +16,14 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
+	"net"
+	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
@@ -35,8 +42,100 @@ const (
 
 var (
 	opsGenieTypeRe = regexp.MustCompile("^(team|user|escalation|schedule)$")
+	timeOfDayRe    = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+	weekdayNames = map[string]bool{
+		"sunday": true, "monday": true, "tuesday": true, "wednesday": true,
+		"thursday": true, "friday": true, "saturday": true,
+	}
+
+	monthNames = map[string]bool{
+		"january": true, "february": true, "march": true, "april": true,
+		"may": true, "june": true, "july": true, "august": true,
+		"september": true, "october": true, "november": true, "december": true,
+	}
 )
 
+// stringPtrValue returns the dereferenced value of s, or the empty string if
+// s is nil, so that a nil *string and a pointer to "" compare as equal.
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// stringPtrEqual reports whether a and b hold the same string, treating nil
+// the same as a pointer to the empty string.
+func stringPtrEqual(a, b *string) bool {
+	return stringPtrValue(a) == stringPtrValue(b)
+}
+
+// boolPtrValue returns the dereferenced value of b, or false if b is nil.
+func boolPtrValue(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+// boolPtrEqual reports whether a and b hold the same bool, treating nil the
+// same as a pointer to false.
+func boolPtrEqual(a, b *bool) bool {
+	return boolPtrValue(a) == boolPtrValue(b)
+}
+
+// sortedStrings returns a sorted copy of in, leaving in untouched.
+func sortedStrings(in []string) []string {
+	out := make([]string, len(in))
+	copy(out, in)
+	sort.Strings(out)
+	return out
+}
+
+// stringSlicesEqual reports whether a and b contain the same strings,
+// ignoring order.
+func stringSlicesEqual(a, b []string) bool {
+	as, bs := sortedStrings(a), sortedStrings(b)
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matcherKey returns a sort key that identifies a Matcher by its semantic
+// content, for use when comparing Matcher slices without regard to order.
+func matcherKey(m Matcher) string {
+	return m.Name + "\x00" + m.Value + "\x00" + string(m.EffectiveMatchType())
+}
+
+// matchersEqual reports whether a and b contain the same matchers, ignoring
+// order. On mismatch it also returns a path describing the first difference,
+// rooted at the offending element's index in a (e.g. "[0].value").
+func matchersEqual(a, b []Matcher) (bool, string) {
+	if len(a) != len(b) {
+		return false, ""
+	}
+	ai, bs := make([]int, len(a)), make([]Matcher, len(b))
+	for i := range ai {
+		ai[i] = i
+	}
+	copy(bs, b)
+	sort.Slice(ai, func(i, j int) bool { return matcherKey(a[ai[i]]) < matcherKey(a[ai[j]]) })
+	sort.Slice(bs, func(i, j int) bool { return matcherKey(bs[i]) < matcherKey(bs[j]) })
+	for i := range ai {
+		if ok, path := a[ai[i]].Equal(&bs[i]); !ok {
+			return false, fmt.Sprintf("[%d].%s", ai[i], path)
+		}
+	}
+	return true, ""
+}
+
 // AlertmanagerConfig defines a namespaced AlertmanagerConfig to be aggregated across multiple namespaces configuring one Alertmanager.
 // +genclient
 // +k8s:openapi-gen=true
@@ -47,6 +146,27 @@ type AlertmanagerConfig struct {
 	Spec AlertmanagerConfigSpec `json:"spec"`
 }
 
+// Hub marks AlertmanagerConfig as the conversion hub for this API group, as
+// required by sigs.k8s.io/controller-runtime's webhook conversion package.
+// v1alpha1 is the only served version today, so this is a no-op; once a
+// spoke version is introduced, its ConvertTo/ConvertFrom implementations
+// convert through this type (reusing helpers like MatchTypeFromRegex for the
+// Matcher.Regex/MatchType fields) instead of this type needing to change.
+func (c *AlertmanagerConfig) Hub() {}
+
+// Equal reports whether c and other describe semantically the same
+// AlertmanagerConfig. On mismatch it also returns a path describing the
+// first difference, e.g. "spec.route.routes[2].matchers[0].value".
+func (c *AlertmanagerConfig) Equal(other *AlertmanagerConfig) (bool, string) {
+	if c == nil || other == nil {
+		return c == other, ""
+	}
+	if ok, path := c.Spec.Equal(&other.Spec); !ok {
+		return false, "spec." + path
+	}
+	return true, ""
+}
+
 // AlertmanagerConfigList is a list of AlertmanagerConfig.
 // +k8s:openapi-gen=true
 type AlertmanagerConfigList struct {
@@ -59,20 +179,200 @@ type AlertmanagerConfigList struct {
 }
 
 type AlertmanagerConfigSpec struct {
-	Route        *Route        `json:"route,omitempty"`
-	Receivers    []Receiver    `json:"receivers,omitempty"`
-	InhibitRules []InhibitRule `json:"inhibitRules,omitempty"`
+	Global        *GlobalConfig  `json:"global,omitempty"`
+	Route         *Route         `json:"route,omitempty"`
+	Receivers     []Receiver     `json:"receivers,omitempty"`
+	InhibitRules  []InhibitRule  `json:"inhibitRules,omitempty"`
+	TimeIntervals []TimeInterval `json:"timeIntervals,omitempty"`
+}
+
+// Equal reports whether s and other describe the semantically same spec,
+// ignoring the order of Receivers. On mismatch it also returns a path
+// describing the first difference.
+func (s *AlertmanagerConfigSpec) Equal(other *AlertmanagerConfigSpec) (bool, string) {
+	if s == nil || other == nil {
+		return s == other, ""
+	}
+	if ok, path := s.Global.Equal(other.Global); !ok {
+		return false, "global." + path
+	}
+	if ok, path := s.Route.Equal(other.Route); !ok {
+		return false, "route." + path
+	}
+	if len(s.Receivers) != len(other.Receivers) {
+		return false, "receivers"
+	}
+	ar, br := make([]int, len(s.Receivers)), make([]Receiver, len(other.Receivers))
+	for i := range ar {
+		ar[i] = i
+	}
+	copy(br, other.Receivers)
+	sort.Slice(ar, func(i, j int) bool { return s.Receivers[ar[i]].Name < s.Receivers[ar[j]].Name })
+	sort.Slice(br, func(i, j int) bool { return br[i].Name < br[j].Name })
+	for i := range ar {
+		if ok, path := s.Receivers[ar[i]].Equal(&br[i]); !ok {
+			return false, fmt.Sprintf("receivers[%d].%s", ar[i], path)
+		}
+	}
+	if len(s.InhibitRules) != len(other.InhibitRules) {
+		return false, "inhibitRules"
+	}
+	for i := range s.InhibitRules {
+		if ok, path := s.InhibitRules[i].IsEqual(&other.InhibitRules[i]); !ok {
+			return false, fmt.Sprintf("inhibitRules[%d].%s", i, path)
+		}
+	}
+	if len(s.TimeIntervals) != len(other.TimeIntervals) {
+		return false, "timeIntervals"
+	}
+	at, bt := make([]int, len(s.TimeIntervals)), make([]TimeInterval, len(other.TimeIntervals))
+	for i := range at {
+		at[i] = i
+	}
+	copy(bt, other.TimeIntervals)
+	sort.Slice(at, func(i, j int) bool { return s.TimeIntervals[at[i]].Name < s.TimeIntervals[at[j]].Name })
+	sort.Slice(bt, func(i, j int) bool { return bt[i].Name < bt[j].Name })
+	for i := range at {
+		if ok, path := s.TimeIntervals[at[i]].Equal(&bt[i]); !ok {
+			return false, fmt.Sprintf("timeIntervals[%d].%s", at[i], path)
+		}
+	}
+	return true, ""
+}
+
+// GlobalConfig holds defaults that are shared across the receivers generated from
+// every AlertmanagerConfig object aggregated into an Alertmanager configuration, so
+// that secrets like SMTP or chat provider credentials don't need to be repeated on
+// every single receiver.
+type GlobalConfig struct {
+	SMTPFrom         *string               `json:"smtpFrom,omitempty"`
+	SMTPSmarthost    *string               `json:"smtpSmarthost,omitempty"`
+	SMTPAuthUsername *string               `json:"smtpAuthUsername,omitempty"`
+	SMTPAuthPassword *v1.SecretKeySelector `json:"smtpAuthPassword,omitempty"`
+	SMTPRequireTLS   *bool                 `json:"smtpRequireTLS,omitempty"`
+	SlackAPIURL      *v1.SecretKeySelector `json:"slackAPIURL,omitempty"`
+	PagerdutyURL     *string               `json:"pagerdutyURL,omitempty"`
+	OpsGenieAPIKey   *v1.SecretKeySelector `json:"opsGenieAPIKey,omitempty"`
+	OpsGenieAPIURL   *string               `json:"opsGenieAPIURL,omitempty"`
+	WeChatAPIURL     *string               `json:"weChatAPIURL,omitempty"`
+	WeChatAPISecret  *v1.SecretKeySelector `json:"weChatAPISecret,omitempty"`
+	WeChatAPICorpID  *string               `json:"weChatAPICorpID,omitempty"`
+	VictorOpsAPIKey  *v1.SecretKeySelector `json:"victorOpsAPIKey,omitempty"`
+	VictorOpsAPIURL  *string               `json:"victorOpsAPIURL,omitempty"`
+	ResolveTimeout   *string               `json:"resolveTimeout,omitempty"`
+}
+
+// Equal reports whether g and other are the semantically same global config,
+// treating nil and pointer-to-"" (or pointer-to-false) fields as equivalent.
+// On mismatch it also returns the name of the first field that differs.
+func (g *GlobalConfig) Equal(other *GlobalConfig) (bool, string) {
+	if g == nil || other == nil {
+		return g == other, ""
+	}
+	if !stringPtrEqual(g.SMTPFrom, other.SMTPFrom) {
+		return false, "smtpFrom"
+	}
+	if !stringPtrEqual(g.SMTPSmarthost, other.SMTPSmarthost) {
+		return false, "smtpSmarthost"
+	}
+	if !stringPtrEqual(g.SMTPAuthUsername, other.SMTPAuthUsername) {
+		return false, "smtpAuthUsername"
+	}
+	if !reflect.DeepEqual(g.SMTPAuthPassword, other.SMTPAuthPassword) {
+		return false, "smtpAuthPassword"
+	}
+	if !boolPtrEqual(g.SMTPRequireTLS, other.SMTPRequireTLS) {
+		return false, "smtpRequireTLS"
+	}
+	if !reflect.DeepEqual(g.SlackAPIURL, other.SlackAPIURL) {
+		return false, "slackAPIURL"
+	}
+	if !stringPtrEqual(g.PagerdutyURL, other.PagerdutyURL) {
+		return false, "pagerdutyURL"
+	}
+	if !reflect.DeepEqual(g.OpsGenieAPIKey, other.OpsGenieAPIKey) {
+		return false, "opsGenieAPIKey"
+	}
+	if !stringPtrEqual(g.OpsGenieAPIURL, other.OpsGenieAPIURL) {
+		return false, "opsGenieAPIURL"
+	}
+	if !stringPtrEqual(g.WeChatAPIURL, other.WeChatAPIURL) {
+		return false, "weChatAPIURL"
+	}
+	if !reflect.DeepEqual(g.WeChatAPISecret, other.WeChatAPISecret) {
+		return false, "weChatAPISecret"
+	}
+	if !stringPtrEqual(g.WeChatAPICorpID, other.WeChatAPICorpID) {
+		return false, "weChatAPICorpID"
+	}
+	if !reflect.DeepEqual(g.VictorOpsAPIKey, other.VictorOpsAPIKey) {
+		return false, "victorOpsAPIKey"
+	}
+	if !stringPtrEqual(g.VictorOpsAPIURL, other.VictorOpsAPIURL) {
+		return false, "victorOpsAPIURL"
+	}
+	if !stringPtrEqual(g.ResolveTimeout, other.ResolveTimeout) {
+		return false, "resolveTimeout"
+	}
+	return true, ""
 }
 
 type Route struct {
-	Receiver       string    `json:"receiver,omitempty"`
-	GroupBy        []string  `json:"groupBy,omitempty"`
-	GroupWait      string    `json:"groupWait,omitempty"`
-	GroupInterval  string    `json:"groupInterval,omitempty"`
-	RepeatInterval string    `json:"repeatInterval,omitempty"`
-	Matchers       []Matcher `json:"matchers,omitempty"`
-	Continue       bool      `json:"continue,omitempty"`
-	Routes         []Route   `json:"routes,omitempty"`
+	Receiver            string    `json:"receiver,omitempty"`
+	GroupBy             []string  `json:"groupBy,omitempty"`
+	GroupWait           string    `json:"groupWait,omitempty"`
+	GroupInterval       string    `json:"groupInterval,omitempty"`
+	RepeatInterval      string    `json:"repeatInterval,omitempty"`
+	Matchers            []Matcher `json:"matchers,omitempty"`
+	Continue            bool      `json:"continue,omitempty"`
+	Routes              []Route   `json:"routes,omitempty"`
+	MuteTimeIntervals   []string  `json:"muteTimeIntervals,omitempty"`
+	ActiveTimeIntervals []string  `json:"activeTimeIntervals,omitempty"`
+}
+
+// Equal reports whether r and other are semantically the same route tree,
+// ignoring the order of GroupBy and Matchers. On mismatch it also returns a
+// path describing the first difference, e.g. "routes[2].matchers[0].value".
+func (r *Route) Equal(other *Route) (bool, string) {
+	if r == nil || other == nil {
+		return r == other, ""
+	}
+	if r.Receiver != other.Receiver {
+		return false, "receiver"
+	}
+	if !stringSlicesEqual(r.GroupBy, other.GroupBy) {
+		return false, "groupBy"
+	}
+	if r.GroupWait != other.GroupWait {
+		return false, "groupWait"
+	}
+	if r.GroupInterval != other.GroupInterval {
+		return false, "groupInterval"
+	}
+	if r.RepeatInterval != other.RepeatInterval {
+		return false, "repeatInterval"
+	}
+	if ok, path := matchersEqual(r.Matchers, other.Matchers); !ok {
+		return false, "matchers" + path
+	}
+	if r.Continue != other.Continue {
+		return false, "continue"
+	}
+	if !stringSlicesEqual(r.MuteTimeIntervals, other.MuteTimeIntervals) {
+		return false, "muteTimeIntervals"
+	}
+	if !stringSlicesEqual(r.ActiveTimeIntervals, other.ActiveTimeIntervals) {
+		return false, "activeTimeIntervals"
+	}
+	if len(r.Routes) != len(other.Routes) {
+		return false, "routes"
+	}
+	for i := range r.Routes {
+		if ok, path := r.Routes[i].Equal(&other.Routes[i]); !ok {
+			return false, fmt.Sprintf("routes[%d].%s", i, path)
+		}
+	}
+	return true, ""
 }
 
 type Receiver struct {
@@ -82,6 +382,86 @@ type Receiver struct {
 	SlackConfigs     []SlackConfig     `json:"slackConfigs,omitempty"`
 	WebhookConfigs   []WebhookConfig   `json:"webhookConfigs,omitempty"`
 	WeChatConfigs    []WeChatConfig    `json:"weChatConfigs,omitempty"`
+	EmailConfigs     []EmailConfig     `json:"emailConfigs,omitempty"`
+	VictorOpsConfigs []VictorOpsConfig `json:"victorOpsConfigs,omitempty"`
+	PushoverConfigs  []PushoverConfig  `json:"pushoverConfigs,omitempty"`
+}
+
+// Equal reports whether rc and other configure semantically the same
+// receiver. On mismatch it also returns a path describing the first
+// difference, e.g. "slackConfigs[0].channel".
+func (rc *Receiver) Equal(other *Receiver) (bool, string) {
+	if rc == nil || other == nil {
+		return rc == other, ""
+	}
+	if rc.Name != other.Name {
+		return false, "name"
+	}
+	if len(rc.OpsGenieConfigs) != len(other.OpsGenieConfigs) {
+		return false, "opsgenieConfigs"
+	}
+	for i := range rc.OpsGenieConfigs {
+		if ok, path := rc.OpsGenieConfigs[i].Equal(&other.OpsGenieConfigs[i]); !ok {
+			return false, fmt.Sprintf("opsgenieConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.PagerDutyConfigs) != len(other.PagerDutyConfigs) {
+		return false, "pagerDutyConfigs"
+	}
+	for i := range rc.PagerDutyConfigs {
+		if ok, path := rc.PagerDutyConfigs[i].Equal(&other.PagerDutyConfigs[i]); !ok {
+			return false, fmt.Sprintf("pagerDutyConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.SlackConfigs) != len(other.SlackConfigs) {
+		return false, "slackConfigs"
+	}
+	for i := range rc.SlackConfigs {
+		if ok, path := rc.SlackConfigs[i].Equal(&other.SlackConfigs[i]); !ok {
+			return false, fmt.Sprintf("slackConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.WebhookConfigs) != len(other.WebhookConfigs) {
+		return false, "webhookConfigs"
+	}
+	for i := range rc.WebhookConfigs {
+		if ok, path := rc.WebhookConfigs[i].Equal(&other.WebhookConfigs[i]); !ok {
+			return false, fmt.Sprintf("webhookConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.WeChatConfigs) != len(other.WeChatConfigs) {
+		return false, "weChatConfigs"
+	}
+	for i := range rc.WeChatConfigs {
+		if ok, path := rc.WeChatConfigs[i].Equal(&other.WeChatConfigs[i]); !ok {
+			return false, fmt.Sprintf("weChatConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.EmailConfigs) != len(other.EmailConfigs) {
+		return false, "emailConfigs"
+	}
+	for i := range rc.EmailConfigs {
+		if ok, path := rc.EmailConfigs[i].Equal(&other.EmailConfigs[i]); !ok {
+			return false, fmt.Sprintf("emailConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.VictorOpsConfigs) != len(other.VictorOpsConfigs) {
+		return false, "victorOpsConfigs"
+	}
+	for i := range rc.VictorOpsConfigs {
+		if ok, path := rc.VictorOpsConfigs[i].Equal(&other.VictorOpsConfigs[i]); !ok {
+			return false, fmt.Sprintf("victorOpsConfigs[%d].%s", i, path)
+		}
+	}
+	if len(rc.PushoverConfigs) != len(other.PushoverConfigs) {
+		return false, "pushoverConfigs"
+	}
+	for i := range rc.PushoverConfigs {
+		if ok, path := rc.PushoverConfigs[i].Equal(&other.PushoverConfigs[i]); !ok {
+			return false, fmt.Sprintf("pushoverConfigs[%d].%s", i, path)
+		}
+	}
+	return true, ""
 }
 
 type PagerDutyConfig struct {
@@ -100,6 +480,55 @@ type PagerDutyConfig struct {
 	HTTPConfig   *HTTPConfig             `json:"httpConfig,omitempty"`
 }
 
+// Equal reports whether p and other configure the semantically same
+// PagerDuty receiver. On mismatch it also returns the name of the first
+// field that differs.
+func (p *PagerDutyConfig) Equal(other *PagerDutyConfig) (bool, string) {
+	if p == nil || other == nil {
+		return p == other, ""
+	}
+	if !boolPtrEqual(p.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(p.RoutingKey, other.RoutingKey) {
+		return false, "routingKey"
+	}
+	if !reflect.DeepEqual(p.ServiceKey, other.ServiceKey) {
+		return false, "serviceKey"
+	}
+	if !stringPtrEqual(p.URL, other.URL) {
+		return false, "url"
+	}
+	if !stringPtrEqual(p.Client, other.Client) {
+		return false, "client"
+	}
+	if !stringPtrEqual(p.ClientURL, other.ClientURL) {
+		return false, "clientURL"
+	}
+	if !stringPtrEqual(p.Description, other.Description) {
+		return false, "description"
+	}
+	if !stringPtrEqual(p.Severity, other.Severity) {
+		return false, "severity"
+	}
+	if !stringPtrEqual(p.Class, other.Class) {
+		return false, "class"
+	}
+	if !stringPtrEqual(p.Group, other.Group) {
+		return false, "group"
+	}
+	if !stringPtrEqual(p.Component, other.Component) {
+		return false, "component"
+	}
+	if !reflect.DeepEqual(p.Details, other.Details) {
+		return false, "details"
+	}
+	if ok, path := p.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
 // SlackConfig configures notifications via Slack.
 type SlackConfig struct {
 	SendResolved *bool                 `json:"sendResolved,omitempty"`
@@ -141,6 +570,82 @@ func (sc *SlackConfig) Validate() error {
 	return nil
 }
 
+// Equal reports whether sc and other configure the semantically same Slack
+// receiver. On mismatch it also returns the name of the first field that
+// differs.
+func (sc *SlackConfig) Equal(other *SlackConfig) (bool, string) {
+	if sc == nil || other == nil {
+		return sc == other, ""
+	}
+	if !boolPtrEqual(sc.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(sc.APIURL, other.APIURL) {
+		return false, "apiURL"
+	}
+	if !stringPtrEqual(sc.Channel, other.Channel) {
+		return false, "channel"
+	}
+	if !stringPtrEqual(sc.Username, other.Username) {
+		return false, "username"
+	}
+	if !stringPtrEqual(sc.Color, other.Color) {
+		return false, "color"
+	}
+	if !stringPtrEqual(sc.Title, other.Title) {
+		return false, "title"
+	}
+	if !stringPtrEqual(sc.TitleLink, other.TitleLink) {
+		return false, "titleLink"
+	}
+	if !stringPtrEqual(sc.Pretext, other.Pretext) {
+		return false, "pretext"
+	}
+	if !stringPtrEqual(sc.Text, other.Text) {
+		return false, "text"
+	}
+	if !reflect.DeepEqual(sc.Fields, other.Fields) {
+		return false, "fields"
+	}
+	if !boolPtrEqual(sc.ShortFields, other.ShortFields) {
+		return false, "shortFields"
+	}
+	if !stringPtrEqual(sc.Footer, other.Footer) {
+		return false, "footer"
+	}
+	if !stringPtrEqual(sc.Fallback, other.Fallback) {
+		return false, "fallback"
+	}
+	if !stringPtrEqual(sc.CallbackID, other.CallbackID) {
+		return false, "callbackId"
+	}
+	if !stringPtrEqual(sc.IconEmoji, other.IconEmoji) {
+		return false, "iconEmoji"
+	}
+	if !stringPtrEqual(sc.IconURL, other.IconURL) {
+		return false, "iconURL"
+	}
+	if !stringPtrEqual(sc.ImageURL, other.ImageURL) {
+		return false, "imageURL"
+	}
+	if !stringPtrEqual(sc.ThumbURL, other.ThumbURL) {
+		return false, "thumbURL"
+	}
+	if !boolPtrEqual(sc.LinkNames, other.LinkNames) {
+		return false, "linkNames"
+	}
+	if !stringSlicesEqual(sc.MrkdwnIn, other.MrkdwnIn) {
+		return false, "mrkdwnIn"
+	}
+	if !reflect.DeepEqual(sc.Actions, other.Actions) {
+		return false, "actions"
+	}
+	if ok, path := sc.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
 // SlackAction configures a single Slack action that is sent with each notification.
 // See https://api.slack.com/docs/message-attachments#action_fields and https://api.slack.com/docs/message-buttons
 // for more information.
@@ -220,6 +725,31 @@ type WebhookConfig struct {
 	MaxAlerts    *int32                `json:"maxAlerts,omitempty"`
 }
 
+// Equal reports whether w and other configure the semantically same webhook
+// receiver. On mismatch it also returns the name of the first field that
+// differs.
+func (w *WebhookConfig) Equal(other *WebhookConfig) (bool, string) {
+	if w == nil || other == nil {
+		return w == other, ""
+	}
+	if !boolPtrEqual(w.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !stringPtrEqual(w.URL, other.URL) {
+		return false, "url"
+	}
+	if !reflect.DeepEqual(w.URLSecret, other.URLSecret) {
+		return false, "urlSecret"
+	}
+	if ok, path := w.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	if !reflect.DeepEqual(w.MaxAlerts, other.MaxAlerts) {
+		return false, "maxAlerts"
+	}
+	return true, ""
+}
+
 type OpsGenieConfig struct {
 	SendResolved *bool                     `json:"sendResolved,omitempty"`
 	APIKey       *v1.SecretKeySelector     `json:"apiKey,omitempty"`
@@ -245,6 +775,52 @@ func (o *OpsGenieConfig) Validate() error {
 	return nil
 }
 
+// Equal reports whether o and other configure the semantically same
+// OpsGenie receiver. On mismatch it also returns the name of the first
+// field that differs.
+func (o *OpsGenieConfig) Equal(other *OpsGenieConfig) (bool, string) {
+	if o == nil || other == nil {
+		return o == other, ""
+	}
+	if !boolPtrEqual(o.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(o.APIKey, other.APIKey) {
+		return false, "apiKey"
+	}
+	if !stringPtrEqual(o.APIURL, other.APIURL) {
+		return false, "apiURL"
+	}
+	if !stringPtrEqual(o.Message, other.Message) {
+		return false, "message"
+	}
+	if !stringPtrEqual(o.Description, other.Description) {
+		return false, "description"
+	}
+	if !stringPtrEqual(o.Source, other.Source) {
+		return false, "source"
+	}
+	if !stringPtrEqual(o.Tags, other.Tags) {
+		return false, "tags"
+	}
+	if !stringPtrEqual(o.Note, other.Note) {
+		return false, "note"
+	}
+	if !stringPtrEqual(o.Priority, other.Priority) {
+		return false, "priority"
+	}
+	if !reflect.DeepEqual(o.Details, other.Details) {
+		return false, "details"
+	}
+	if !reflect.DeepEqual(o.Responders, other.Responders) {
+		return false, "responders"
+	}
+	if ok, path := o.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
 type OpsGenieConfigResponder struct {
 	ID       string `json:"id,omitempty"`
 	Name     string `json:"name,omitempty"`
@@ -272,6 +848,28 @@ type HTTPConfig struct {
 	ProxyURL          *string                     `json:"proxyURL,omitempty"`
 }
 
+// Equal reports whether h and other are the semantically same HTTP config,
+// treating nil and pointer-to-"" fields as equivalent. On mismatch it also
+// returns the name of the first field that differs.
+func (h *HTTPConfig) Equal(other *HTTPConfig) (bool, string) {
+	if h == nil || other == nil {
+		return h == other, ""
+	}
+	if !reflect.DeepEqual(h.BasicAuth, other.BasicAuth) {
+		return false, "basicAuth"
+	}
+	if !reflect.DeepEqual(h.BearerTokenSecret, other.BearerTokenSecret) {
+		return false, "bearerTokenSecret"
+	}
+	if !reflect.DeepEqual(h.TLSConfig, other.TLSConfig) {
+		return false, "tlsConfig"
+	}
+	if !stringPtrEqual(h.ProxyURL, other.ProxyURL) {
+		return false, "proxyURL"
+	}
+	return true, ""
+}
+
 type OpsGenieConfigDetail struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -296,16 +894,623 @@ type WeChatConfig struct {
 	HTTPConfig   *HTTPConfig           `json:"httpConfig,omitempty"`
 }
 
+// Equal reports whether w and other configure the semantically same WeChat
+// receiver. On mismatch it also returns the name of the first field that
+// differs.
+func (w *WeChatConfig) Equal(other *WeChatConfig) (bool, string) {
+	if w == nil || other == nil {
+		return w == other, ""
+	}
+	if !boolPtrEqual(w.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(w.APISecret, other.APISecret) {
+		return false, "apiSecret"
+	}
+	if !stringPtrEqual(w.APIURL, other.APIURL) {
+		return false, "apiURL"
+	}
+	if !stringPtrEqual(w.CorpID, other.CorpID) {
+		return false, "corpID"
+	}
+	if !stringPtrEqual(w.AgentID, other.AgentID) {
+		return false, "agentID"
+	}
+	if !stringPtrEqual(w.ToUser, other.ToUser) {
+		return false, "toUser"
+	}
+	if !stringPtrEqual(w.ToParty, other.ToParty) {
+		return false, "toParty"
+	}
+	if !stringPtrEqual(w.ToTag, other.ToTag) {
+		return false, "toTag"
+	}
+	if !stringPtrEqual(w.Message, other.Message) {
+		return false, "message"
+	}
+	if !stringPtrEqual(w.MessageType, other.MessageType) {
+		return false, "messageType"
+	}
+	if ok, path := w.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
+// EmailConfig configures notifications via Email.
+type EmailConfig struct {
+	SendResolved *bool                       `json:"sendResolved,omitempty"`
+	To           string                      `json:"to,omitempty"`
+	From         string                      `json:"from,omitempty"`
+	Hello        string                      `json:"hello,omitempty"`
+	Smarthost    string                      `json:"smarthost,omitempty"`
+	AuthUsername string                      `json:"authUsername,omitempty"`
+	AuthPassword *v1.SecretKeySelector       `json:"authPassword,omitempty"`
+	AuthSecret   *v1.SecretKeySelector       `json:"authSecret,omitempty"`
+	AuthIdentity string                      `json:"authIdentity,omitempty"`
+	RequireTLS   *bool                       `json:"requireTLS,omitempty"`
+	TLSConfig    *monitoringv1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	HTML         string                      `json:"html,omitempty"`
+	Text         string                      `json:"text,omitempty"`
+	Headers      []EmailHeader               `json:"headers,omitempty"`
+}
+
+// Validate ensures EmailConfig is valid
+func (e *EmailConfig) Validate() error {
+	if e.To == "" {
+		return errors.New("missing to address in email config")
+	}
+	if e.Smarthost != "" {
+		if _, _, err := net.SplitHostPort(e.Smarthost); err != nil {
+			return errors.New("invalid email field smarthost, expected host:port")
+		}
+	}
+	for _, header := range e.Headers {
+		if err := header.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equal reports whether e and other configure the semantically same email
+// receiver. On mismatch it also returns the name of the first field that
+// differs.
+func (e *EmailConfig) Equal(other *EmailConfig) (bool, string) {
+	if e == nil || other == nil {
+		return e == other, ""
+	}
+	if !boolPtrEqual(e.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if e.To != other.To {
+		return false, "to"
+	}
+	if e.From != other.From {
+		return false, "from"
+	}
+	if e.Hello != other.Hello {
+		return false, "hello"
+	}
+	if e.Smarthost != other.Smarthost {
+		return false, "smarthost"
+	}
+	if e.AuthUsername != other.AuthUsername {
+		return false, "authUsername"
+	}
+	if !reflect.DeepEqual(e.AuthPassword, other.AuthPassword) {
+		return false, "authPassword"
+	}
+	if !reflect.DeepEqual(e.AuthSecret, other.AuthSecret) {
+		return false, "authSecret"
+	}
+	if e.AuthIdentity != other.AuthIdentity {
+		return false, "authIdentity"
+	}
+	if !boolPtrEqual(e.RequireTLS, other.RequireTLS) {
+		return false, "requireTLS"
+	}
+	if !reflect.DeepEqual(e.TLSConfig, other.TLSConfig) {
+		return false, "tlsConfig"
+	}
+	if e.HTML != other.HTML {
+		return false, "html"
+	}
+	if e.Text != other.Text {
+		return false, "text"
+	}
+	if !reflect.DeepEqual(e.Headers, other.Headers) {
+		return false, "headers"
+	}
+	return true, ""
+}
+
+// EmailHeader configures a single header to be sent with an Email notification.
+type EmailHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Validate ensures EmailHeader is valid
+func (h *EmailHeader) Validate() error {
+	if h.Key == "" {
+		return errors.New("missing key in email header configuration")
+	}
+	return nil
+}
+
+// VictorOpsConfig configures notifications via VictorOps.
+type VictorOpsConfig struct {
+	SendResolved      *bool                        `json:"sendResolved,omitempty"`
+	APIKey            *v1.SecretKeySelector        `json:"apiKey,omitempty"`
+	APIURL            *string                      `json:"apiURL,omitempty"`
+	RoutingKey        string                       `json:"routingKey"`
+	MessageType       *string                      `json:"messageType,omitempty"`
+	EntityDisplayName *string                      `json:"entityDisplayName,omitempty"`
+	StateMessage      *string                      `json:"stateMessage,omitempty"`
+	MonitoringTool    *string                      `json:"monitoringTool,omitempty"`
+	CustomFields      []VictorOpsConfigCustomField `json:"customFields,omitempty"`
+	HTTPConfig        *HTTPConfig                  `json:"httpConfig,omitempty"`
+}
+
+// Validate ensures VictorOpsConfig is valid
+func (v *VictorOpsConfig) Validate() error {
+	if v.RoutingKey == "" {
+		return errors.New("missing routing key in VictorOps config")
+	}
+	return nil
+}
+
+// Equal reports whether v and other configure the semantically same
+// VictorOps receiver. On mismatch it also returns the name of the first
+// field that differs.
+func (v *VictorOpsConfig) Equal(other *VictorOpsConfig) (bool, string) {
+	if v == nil || other == nil {
+		return v == other, ""
+	}
+	if !boolPtrEqual(v.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(v.APIKey, other.APIKey) {
+		return false, "apiKey"
+	}
+	if !stringPtrEqual(v.APIURL, other.APIURL) {
+		return false, "apiURL"
+	}
+	if v.RoutingKey != other.RoutingKey {
+		return false, "routingKey"
+	}
+	if !stringPtrEqual(v.MessageType, other.MessageType) {
+		return false, "messageType"
+	}
+	if !stringPtrEqual(v.EntityDisplayName, other.EntityDisplayName) {
+		return false, "entityDisplayName"
+	}
+	if !stringPtrEqual(v.StateMessage, other.StateMessage) {
+		return false, "stateMessage"
+	}
+	if !stringPtrEqual(v.MonitoringTool, other.MonitoringTool) {
+		return false, "monitoringTool"
+	}
+	if !reflect.DeepEqual(v.CustomFields, other.CustomFields) {
+		return false, "customFields"
+	}
+	if ok, path := v.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
+// VictorOpsConfigCustomField configures a single custom field to be sent with each VictorOps notification.
+type VictorOpsConfigCustomField struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PushoverConfig configures notifications via Pushover.
+type PushoverConfig struct {
+	SendResolved *bool                 `json:"sendResolved,omitempty"`
+	UserKey      *v1.SecretKeySelector `json:"userKey,omitempty"`
+	Token        *v1.SecretKeySelector `json:"token,omitempty"`
+	Title        *string               `json:"title,omitempty"`
+	Message      *string               `json:"message,omitempty"`
+	URL          *string               `json:"url,omitempty"`
+	URLTitle     *string               `json:"urlTitle,omitempty"`
+	Sound        *string               `json:"sound,omitempty"`
+	Priority     *string               `json:"priority,omitempty"`
+	Retry        *string               `json:"retry,omitempty"`
+	Expire       *string               `json:"expire,omitempty"`
+	HTML         *bool                 `json:"html,omitempty"`
+	HTTPConfig   *HTTPConfig           `json:"httpConfig,omitempty"`
+}
+
+// Validate ensures PushoverConfig is valid
+func (p *PushoverConfig) Validate() error {
+	if p.UserKey == nil {
+		return errors.New("missing user key in Pushover config")
+	}
+	if p.Token == nil {
+		return errors.New("missing token in Pushover config")
+	}
+	return nil
+}
+
+// Equal reports whether p and other configure the semantically same
+// Pushover receiver. On mismatch it also returns the name of the first
+// field that differs.
+func (p *PushoverConfig) Equal(other *PushoverConfig) (bool, string) {
+	if p == nil || other == nil {
+		return p == other, ""
+	}
+	if !boolPtrEqual(p.SendResolved, other.SendResolved) {
+		return false, "sendResolved"
+	}
+	if !reflect.DeepEqual(p.UserKey, other.UserKey) {
+		return false, "userKey"
+	}
+	if !reflect.DeepEqual(p.Token, other.Token) {
+		return false, "token"
+	}
+	if !stringPtrEqual(p.Title, other.Title) {
+		return false, "title"
+	}
+	if !stringPtrEqual(p.Message, other.Message) {
+		return false, "message"
+	}
+	if !stringPtrEqual(p.URL, other.URL) {
+		return false, "url"
+	}
+	if !stringPtrEqual(p.URLTitle, other.URLTitle) {
+		return false, "urlTitle"
+	}
+	if !stringPtrEqual(p.Sound, other.Sound) {
+		return false, "sound"
+	}
+	if !stringPtrEqual(p.Priority, other.Priority) {
+		return false, "priority"
+	}
+	if !stringPtrEqual(p.Retry, other.Retry) {
+		return false, "retry"
+	}
+	if !stringPtrEqual(p.Expire, other.Expire) {
+		return false, "expire"
+	}
+	if !boolPtrEqual(p.HTML, other.HTML) {
+		return false, "html"
+	}
+	if ok, path := p.HTTPConfig.Equal(other.HTTPConfig); !ok {
+		return false, "httpConfig." + path
+	}
+	return true, ""
+}
+
 type InhibitRule struct {
 	TargetMatch []Matcher `json:"targetMatch,omitempty"`
 	SourceMatch []Matcher `json:"sourceMatch,omitempty"`
 	Equal       []string  `json:"equal,omitempty"`
 }
 
+// IsEqual reports whether ir and other are semantically the same inhibition
+// rule, ignoring the order of TargetMatch, SourceMatch and Equal. It is named
+// IsEqual rather than Equal because InhibitRule already has an Equal field.
+// On mismatch it also returns a path describing the first difference.
+func (ir *InhibitRule) IsEqual(other *InhibitRule) (bool, string) {
+	if ir == nil || other == nil {
+		return ir == other, ""
+	}
+	if ok, path := matchersEqual(ir.TargetMatch, other.TargetMatch); !ok {
+		return false, "targetMatch" + path
+	}
+	if ok, path := matchersEqual(ir.SourceMatch, other.SourceMatch); !ok {
+		return false, "sourceMatch" + path
+	}
+	if !stringSlicesEqual(ir.Equal, other.Equal) {
+		return false, "equal"
+	}
+	return true, ""
+}
+
+// MatchType is a comparison operator on a Matcher.
+// +kubebuilder:validation:Enum=!=;=;=~;!~
+type MatchType string
+
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
 type Matcher struct {
-	Name  string `json:"name"`
+	Name string `json:"name"`
+	// Value to match against.
 	Value string `json:"value"`
-	Regex bool   `json:"regex,omitempty"`
+	// MatchType is the comparison operator to apply, one of "=", "!=", "=~" or "!~".
+	// If MatchType is unset, Regex is used instead to pick between "=" and "=~" for
+	// backward compatibility with existing AlertmanagerConfig objects.
+	MatchType MatchType `json:"matchType,omitempty"`
+	// Regex selects whether the Value should be matched as a regular expression.
+	// Deprecated: use MatchType instead.
+	Regex bool `json:"regex,omitempty"`
+}
+
+// Validate ensures Matcher is valid
+func (m *Matcher) Validate() error {
+	if m.Name == "" {
+		return errors.New("matcher must have a name")
+	}
+	switch m.MatchType {
+	case MatchEqual, MatchNotEqual, MatchRegexp, MatchNotRegexp, "":
+	default:
+		return errors.New(`matchType must be one of "=", "!=", "=~" or "!~"`)
+	}
+	return nil
+}
+
+// MatchTypeFromRegex maps the legacy Regex boolean to its equivalent
+// MatchType ("=~" when regex is true, "=" otherwise). It is exported so that
+// conversion code for future API versions can reuse the same mapping that
+// EffectiveMatchType applies here.
+func MatchTypeFromRegex(regex bool) MatchType {
+	if regex {
+		return MatchRegexp
+	}
+	return MatchEqual
+}
+
+// EffectiveMatchType returns the MatchType to use, falling back to the
+// legacy Regex boolean via MatchTypeFromRegex when MatchType is not set.
+func (m *Matcher) EffectiveMatchType() MatchType {
+	if m.MatchType != "" {
+		return m.MatchType
+	}
+	return MatchTypeFromRegex(m.Regex)
+}
+
+// Equal reports whether m and other are semantically the same matcher. On
+// mismatch it also returns the name of the first field that differs.
+func (m *Matcher) Equal(other *Matcher) (bool, string) {
+	if m == nil || other == nil {
+		return m == other, ""
+	}
+	if m.Name != other.Name {
+		return false, "name"
+	}
+	if m.Value != other.Value {
+		return false, "value"
+	}
+	if m.EffectiveMatchType() != other.EffectiveMatchType() {
+		return false, "matchType"
+	}
+	return true, ""
+}
+
+// TimeInterval defines a named set of time ranges during which a Route's
+// MuteTimeIntervals/ActiveTimeIntervals can refer to it by Name. When
+// aggregated from a namespaced AlertmanagerConfig, Name is prefixed the same
+// way receiver names are.
+type TimeInterval struct {
+	Name          string             `json:"name"`
+	TimeIntervals []TimeIntervalItem `json:"timeIntervals,omitempty"`
+}
+
+// Validate ensures TimeInterval is valid
+func (ti *TimeInterval) Validate() error {
+	if ti.Name == "" {
+		return errors.New("time interval must have a name")
+	}
+	for _, item := range ti.TimeIntervals {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equal reports whether ti and other are the semantically same named time
+// interval. On mismatch it also returns a path describing the first
+// difference, e.g. "timeIntervals[0].weekdays".
+func (ti *TimeInterval) Equal(other *TimeInterval) (bool, string) {
+	if ti == nil || other == nil {
+		return ti == other, ""
+	}
+	if ti.Name != other.Name {
+		return false, "name"
+	}
+	if len(ti.TimeIntervals) != len(other.TimeIntervals) {
+		return false, "timeIntervals"
+	}
+	for i := range ti.TimeIntervals {
+		if ok, path := ti.TimeIntervals[i].Equal(&other.TimeIntervals[i]); !ok {
+			return false, fmt.Sprintf("timeIntervals[%d].%s", i, path)
+		}
+	}
+	return true, ""
+}
+
+// TimeIntervalItem describes a single time window. All fields are ANDed
+// together; an empty field matches everything for that dimension, mirroring
+// Alertmanager's time_interval mute_time_intervals config.
+type TimeIntervalItem struct {
+	Times       []TimeRange `json:"times,omitempty"`
+	Weekdays    []string    `json:"weekdays,omitempty"`
+	DaysOfMonth []string    `json:"daysOfMonth,omitempty"`
+	Months      []string    `json:"months,omitempty"`
+	Years       []string    `json:"years,omitempty"`
+	Location    *string     `json:"location,omitempty"`
+}
+
+// Validate ensures TimeIntervalItem is valid
+func (tii *TimeIntervalItem) Validate() error {
+	for _, t := range tii.Times {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, w := range tii.Weekdays {
+		if err := validateWeekdayRange(w); err != nil {
+			return err
+		}
+	}
+	for _, d := range tii.DaysOfMonth {
+		if err := validateDayOfMonthRange(d); err != nil {
+			return err
+		}
+	}
+	for _, mo := range tii.Months {
+		if err := validateMonthRange(mo); err != nil {
+			return err
+		}
+	}
+	for _, y := range tii.Years {
+		if err := validateYearRange(y); err != nil {
+			return err
+		}
+	}
+	if tii.Location != nil {
+		if _, err := time.LoadLocation(*tii.Location); err != nil {
+			return fmt.Errorf("invalid location %q: %w", *tii.Location, err)
+		}
+	}
+	return nil
+}
+
+// Equal reports whether tii and other describe the semantically same time
+// window, ignoring the order of Weekdays, DaysOfMonth, Months and Years. On
+// mismatch it also returns the name of the first field that differs.
+func (tii *TimeIntervalItem) Equal(other *TimeIntervalItem) (bool, string) {
+	if tii == nil || other == nil {
+		return tii == other, ""
+	}
+	if len(tii.Times) != len(other.Times) {
+		return false, "times"
+	}
+	for i := range tii.Times {
+		if ok, path := tii.Times[i].Equal(&other.Times[i]); !ok {
+			return false, fmt.Sprintf("times[%d].%s", i, path)
+		}
+	}
+	if !stringSlicesEqual(tii.Weekdays, other.Weekdays) {
+		return false, "weekdays"
+	}
+	if !stringSlicesEqual(tii.DaysOfMonth, other.DaysOfMonth) {
+		return false, "daysOfMonth"
+	}
+	if !stringSlicesEqual(tii.Months, other.Months) {
+		return false, "months"
+	}
+	if !stringSlicesEqual(tii.Years, other.Years) {
+		return false, "years"
+	}
+	if !stringPtrEqual(tii.Location, other.Location) {
+		return false, "location"
+	}
+	return true, ""
+}
+
+// TimeRange is a range of times of day, in "HH:MM" format. EndTime also
+// accepts "24:00" to mean midnight at the end of the day.
+type TimeRange struct {
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+}
+
+// Validate ensures TimeRange is valid
+func (t *TimeRange) Validate() error {
+	start, ok := parseTimeOfDay(t.StartTime)
+	if !ok {
+		return fmt.Errorf("invalid startTime %q, expected HH:MM", t.StartTime)
+	}
+	end, ok := parseTimeOfDay(t.EndTime)
+	if !ok {
+		return fmt.Errorf("invalid endTime %q, expected HH:MM or 24:00", t.EndTime)
+	}
+	if start >= end {
+		return fmt.Errorf("startTime %q must be before endTime %q", t.StartTime, t.EndTime)
+	}
+	return nil
+}
+
+// Equal reports whether t and other are the same time-of-day range. On
+// mismatch it also returns the name of the first field that differs.
+func (t *TimeRange) Equal(other *TimeRange) (bool, string) {
+	if t == nil || other == nil {
+		return t == other, ""
+	}
+	if t.StartTime != other.StartTime {
+		return false, "startTime"
+	}
+	if t.EndTime != other.EndTime {
+		return false, "endTime"
+	}
+	return true, ""
+}
+
+// parseTimeOfDay parses an "HH:MM" string (or the "24:00" end-of-day
+// sentinel) into minutes since midnight.
+func parseTimeOfDay(s string) (int, bool) {
+	if s == "24:00" {
+		return 24 * 60, true
+	}
+	if !timeOfDayRe.MatchString(s) {
+		return 0, false
+	}
+	hh, _ := strconv.Atoi(s[:2])
+	mm, _ := strconv.Atoi(s[3:])
+	return hh*60 + mm, true
+}
+
+// validateWeekdayRange validates a single weekday or "weekday:weekday" range,
+// e.g. "monday" or "monday:friday".
+func validateWeekdayRange(s string) error {
+	parts := strings.SplitN(strings.ToLower(s), ":", 2)
+	for _, p := range parts {
+		if !weekdayNames[p] {
+			return fmt.Errorf("invalid weekday %q", p)
+		}
+	}
+	return nil
+}
+
+// validateMonthRange validates a single month (by name or 1-12 number) or a
+// "month:month" range, e.g. "january", "3" or "january:march".
+func validateMonthRange(s string) error {
+	parts := strings.SplitN(strings.ToLower(s), ":", 2)
+	for _, p := range parts {
+		if monthNames[p] {
+			continue
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 || n > 12 {
+			return fmt.Errorf("invalid month %q", p)
+		}
+	}
+	return nil
+}
+
+// validateDayOfMonthRange validates a day of month, e.g. "1", "-1" (last day)
+// or a range like "1:7" or "-7:-1".
+func validateDayOfMonthRange(s string) error {
+	parts := strings.SplitN(s, ":", 2)
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return fmt.Errorf("invalid day of month %q", p)
+		}
+	}
+	return nil
+}
+
+// validateYearRange validates a year, given as a non-negative integer, or a
+// "year:year" range.
+func validateYearRange(s string) error {
+	parts := strings.SplitN(s, ":", 2)
+	for _, p := range parts {
+		if n, err := strconv.Atoi(p); err != nil || n < 0 {
+			return fmt.Errorf("invalid year %q", p)
+		}
+	}
+	return nil
 }
 
 // DeepCopyObject implements the runtime.Object interface.
@@ -0,0 +1,188 @@
+// Copyright 2020 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import "testing"
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestGlobalConfigEqualNilVsEmptyStringPointer(t *testing.T) {
+	a := &GlobalConfig{SMTPFrom: nil}
+	b := &GlobalConfig{SMTPFrom: strPtr("")}
+
+	ok, path := a.Equal(b)
+	if !ok {
+		t.Fatalf("expected nil and pointer-to-\"\" SMTPFrom to be equal, got diff at %q", path)
+	}
+
+	c := &GlobalConfig{SMTPFrom: strPtr("smtp.example.com")}
+	ok, path = a.Equal(c)
+	if ok {
+		t.Fatal("expected nil and a non-empty SMTPFrom to differ")
+	}
+	if path != "smtpFrom" {
+		t.Fatalf("expected diff path %q, got %q", "smtpFrom", path)
+	}
+}
+
+func TestHTTPConfigEqualNilVsEmptyStringPointer(t *testing.T) {
+	a := &HTTPConfig{ProxyURL: nil}
+	b := &HTTPConfig{ProxyURL: strPtr("")}
+
+	ok, path := a.Equal(b)
+	if !ok {
+		t.Fatalf("expected nil and pointer-to-\"\" ProxyURL to be equal, got diff at %q", path)
+	}
+}
+
+func TestRouteEqualGroupByAndMatchersIgnoreOrder(t *testing.T) {
+	a := &Route{
+		GroupBy: []string{"alertname", "cluster"},
+		Matchers: []Matcher{
+			{Name: "severity", Value: "critical"},
+			{Name: "job", Value: "node"},
+		},
+	}
+	b := &Route{
+		GroupBy: []string{"cluster", "alertname"},
+		Matchers: []Matcher{
+			{Name: "job", Value: "node"},
+			{Name: "severity", Value: "critical"},
+		},
+	}
+
+	ok, path := a.Equal(b)
+	if !ok {
+		t.Fatalf("expected routes to be equal ignoring order, got diff at %q", path)
+	}
+}
+
+func TestAlertmanagerConfigSpecEqualReceiversIgnoreOrder(t *testing.T) {
+	a := &AlertmanagerConfigSpec{
+		Receivers: []Receiver{
+			{Name: "a"},
+			{Name: "b"},
+		},
+	}
+	b := &AlertmanagerConfigSpec{
+		Receivers: []Receiver{
+			{Name: "b"},
+			{Name: "a"},
+		},
+	}
+
+	ok, path := a.Equal(b)
+	if !ok {
+		t.Fatalf("expected specs to be equal ignoring receiver order, got diff at %q", path)
+	}
+}
+
+func TestTimeIntervalEqualWeekdaysIgnoreOrder(t *testing.T) {
+	a := &TimeInterval{
+		Name: "business-hours",
+		TimeIntervals: []TimeIntervalItem{
+			{Weekdays: []string{"monday", "tuesday", "wednesday"}},
+		},
+	}
+	b := &TimeInterval{
+		Name: "business-hours",
+		TimeIntervals: []TimeIntervalItem{
+			{Weekdays: []string{"wednesday", "monday", "tuesday"}},
+		},
+	}
+
+	ok, path := a.Equal(b)
+	if !ok {
+		t.Fatalf("expected time intervals to be equal ignoring weekday order, got diff at %q", path)
+	}
+}
+
+func TestTimeIntervalEqualMismatchedWeekdayReportsPath(t *testing.T) {
+	a := &TimeInterval{
+		Name:          "business-hours",
+		TimeIntervals: []TimeIntervalItem{{Weekdays: []string{"monday"}}},
+	}
+	b := &TimeInterval{
+		Name:          "business-hours",
+		TimeIntervals: []TimeIntervalItem{{Weekdays: []string{"tuesday"}}},
+	}
+
+	ok, path := a.Equal(b)
+	if ok {
+		t.Fatal("expected mismatched weekdays to be unequal")
+	}
+	const want = "timeIntervals[0].weekdays"
+	if path != want {
+		t.Fatalf("expected diff path %q, got %q", want, path)
+	}
+}
+
+func TestAlertmanagerConfigSpecEqualTimeIntervalsReportsNestedPath(t *testing.T) {
+	a := &AlertmanagerConfigSpec{
+		TimeIntervals: []TimeInterval{
+			{Name: "business-hours", TimeIntervals: []TimeIntervalItem{{Times: []TimeRange{{StartTime: "09:00", EndTime: "17:00"}}}}},
+		},
+	}
+	b := &AlertmanagerConfigSpec{
+		TimeIntervals: []TimeInterval{
+			{Name: "business-hours", TimeIntervals: []TimeIntervalItem{{Times: []TimeRange{{StartTime: "09:00", EndTime: "18:00"}}}}},
+		},
+	}
+
+	ok, path := a.Equal(b)
+	if ok {
+		t.Fatal("expected mismatched time ranges to be unequal")
+	}
+	const want = "timeIntervals[0].timeIntervals[0].times[0].endTime"
+	if path != want {
+		t.Fatalf("expected diff path %q, got %q", want, path)
+	}
+}
+
+func TestRouteEqualNestedRouteTreeMismatchedLeaf(t *testing.T) {
+	a := &Route{
+		Receiver: "root",
+		Routes: []Route{
+			{
+				Receiver: "child",
+				Routes: []Route{
+					{Receiver: "leaf", Matchers: []Matcher{{Name: "severity", Value: "critical"}}},
+				},
+			},
+		},
+	}
+	b := &Route{
+		Receiver: "root",
+		Routes: []Route{
+			{
+				Receiver: "child",
+				Routes: []Route{
+					{Receiver: "leaf", Matchers: []Matcher{{Name: "severity", Value: "warning"}}},
+				},
+			},
+		},
+	}
+
+	ok, path := a.Equal(b)
+	if ok {
+		t.Fatal("expected mismatched leaf route to be unequal")
+	}
+	const want = "routes[0].routes[0].matchers[0].value"
+	if path != want {
+		t.Fatalf("expected diff path %q, got %q", want, path)
+	}
+}